@@ -1,11 +1,19 @@
 export declare namespace ContentTree {
     type Block = Node;
-    type Phrasing = Text | Break | Strong | Emphasis | Strikethrough | Link;
+    type Phrasing = Text | Break | Strong | Emphasis | Strikethrough | Subscript | Superscript | Insertion | Deletion | Abbreviation | InlineCode | Link;
     interface ImageSource {
         url: string;
         width: number;
         dpr: number;
     }
+    interface Picture {
+        imageType: "image" | "graphic";
+        alt: string;
+        caption: string;
+        credit: string;
+        images: Image[];
+        fallbackImage: Image;
+    }
     interface TeaserConcept {
         apiUrl: string;
         directType: string;
@@ -69,15 +77,18 @@ export declare namespace ContentTree {
     }
     interface ThematicBreak extends Node {
         type: "thematic-break";
+        variant?: string;
     }
     interface Paragraph extends Parent {
         type: "paragraph";
         children: Phrasing[];
+        fragmentIdentifier?: string;
     }
     interface Heading extends Parent {
         type: "heading";
         children: Text[];
-        level: "chapter" | "subheading" | "label";
+        level: "chapter" | "subheading" | "subheading-2" | "label";
+        fragmentIdentifier?: string;
     }
     interface Strong extends Parent {
         type: "strong";
@@ -91,11 +102,38 @@ export declare namespace ContentTree {
         type: "strikethrough";
         children: Phrasing[];
     }
+    interface Subscript extends Parent {
+        type: "subscript";
+        children: Phrasing[];
+    }
+    interface Superscript extends Parent {
+        type: "superscript";
+        children: Phrasing[];
+    }
+    interface Insertion extends Parent {
+        type: "insertion";
+        children: Phrasing[];
+    }
+    interface Deletion extends Parent {
+        type: "deletion";
+        children: Phrasing[];
+    }
+    interface Abbreviation extends Parent {
+        type: "abbreviation";
+        title: string;
+        children: Phrasing[];
+    }
+    interface InlineCode extends Node {
+        type: "inline-code";
+        value: string;
+    }
     interface Link extends Parent {
         type: "link";
         url: string;
         title: string;
         children: Phrasing[];
+        fragmentIdentifier?: string;
+        contentType?: "Article" | "Content" | "ImageSet";
     }
     interface List extends Parent {
         type: "list";
@@ -108,12 +146,22 @@ export declare namespace ContentTree {
     }
     interface Blockquote extends Parent {
         type: "blockquote";
+        cite?: string;
+        children: Phrasing[];
+    }
+    interface Address extends Parent {
+        type: "address";
         children: Phrasing[];
     }
+    interface CodeBlock extends Node {
+        type: "code-block";
+        value: string;
+    }
     interface Pullquote extends Node {
         type: "pullquote";
         text: string;
         source?: string;
+        picture?: Picture;
     }
     interface Recommended extends Node {
         type: "recommended";
@@ -126,13 +174,12 @@ export declare namespace ContentTree {
         type: "image-set";
         id: string;
         layoutWidth: "inline" | "article" | "grid" | "viewport";
-        picture?: {
-            imageType: "image" | "graphic";
-            alt: string;
-            caption: string;
-            credit: string;
-            images: Image[];
-            fallbackImage: Image;
+        picture?: Picture;
+        fragmentIdentifier?: string;
+        title?: string;
+        label?: string;
+        attributes?: {
+            [key: string]: string;
         };
     }
     interface Image extends Node {
@@ -148,6 +195,19 @@ export declare namespace ContentTree {
         id: string;
         type: "tweet";
         html?: string;
+        title?: string;
+        label?: string;
+    }
+    interface Video extends Node {
+        type: "video";
+        url: string;
+        provider?: "youtube" | "vimeo";
+        embedded?: boolean;
+        title?: string;
+        label?: string;
+        attributes?: {
+            [key: string]: string;
+        };
     }
     interface Flourish extends Node {
         type: "flourish";
@@ -157,6 +217,12 @@ export declare namespace ContentTree {
         description?: string;
         timestamp?: string;
         fallbackImage?: Image;
+        fragmentIdentifier?: string;
+        title?: string;
+        label?: string;
+        attributes?: {
+            [key: string]: string;
+        };
     }
     interface BigNumber extends Parent {
         type: "big-number";